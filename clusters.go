@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/homedir"
+)
+
+// clusterConfig is one cluster k8mon watches Monitors in and/or resolves
+// cluster/namespace/service tuples against: a name (the kubeconfig context
+// it came from, or "local") paired with the rest.Config to reach it.
+type clusterConfig struct {
+	name       string
+	restConfig *rest.Config
+}
+
+// loadClusterConfigs builds one clusterConfig per kubeconfig in kubeconfigs,
+// which may be a comma-separated list of file paths or a directory
+// containing one kubeconfig per file. When kubeconfigs is empty it falls
+// back to a single "local" cluster, built the same way k8mon always has:
+// the kubeconfig under $HOME/.kube, or the in-cluster config if that's not
+// usable.
+func loadClusterConfigs(kubeconfigs string) ([]clusterConfig, error) {
+	if kubeconfigs == "" {
+		var kubeconfig string
+		if home := homedir.HomeDir(); home != "" {
+			kubeconfig = filepath.Join(home, ".kube", "config")
+		}
+
+		config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			fmt.Println("Falling back to in-cluster config")
+			config, err = rest.InClusterConfig()
+			if err != nil {
+				return nil, fmt.Errorf("no --kubeconfigs given, and neither the local kubeconfig nor the in-cluster config is usable: %w", err)
+			}
+		}
+		return []clusterConfig{{name: "local", restConfig: config}}, nil
+	}
+
+	paths, err := expandKubeconfigPaths(kubeconfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]clusterConfig, 0, len(paths))
+	for _, path := range paths {
+		raw, err := clientcmd.LoadFromFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig %s: %w", path, err)
+		}
+
+		config, err := clientcmd.NewNonInteractiveClientConfig(*raw, raw.CurrentContext, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("build config from %s: %w", path, err)
+		}
+
+		clusters = append(clusters, clusterConfig{name: raw.CurrentContext, restConfig: config})
+	}
+	return clusters, nil
+}
+
+// expandKubeconfigPaths turns the --kubeconfigs flag value into a list of
+// kubeconfig file paths: every file in the directory if it names one, or
+// the comma-separated paths otherwise.
+func expandKubeconfigPaths(kubeconfigs string) ([]string, error) {
+	if info, err := os.Stat(kubeconfigs); err == nil && info.IsDir() {
+		entries, err := os.ReadDir(kubeconfigs)
+		if err != nil {
+			return nil, fmt.Errorf("read kubeconfigs dir %s: %w", kubeconfigs, err)
+		}
+
+		paths := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			paths = append(paths, filepath.Join(kubeconfigs, entry.Name()))
+		}
+		return paths, nil
+	}
+
+	return strings.Split(kubeconfigs, ","), nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestExpandKubeconfigPathsCommaList(t *testing.T) {
+	got, err := expandKubeconfigPaths("/tmp/a.yaml,/tmp/b.yaml")
+	if err != nil {
+		t.Fatalf("expandKubeconfigPaths returned an unexpected error: %v", err)
+	}
+	want := []string{"/tmp/a.yaml", "/tmp/b.yaml"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestExpandKubeconfigPathsDirectory(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"cluster-a", "cluster-b"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("kubeconfig"), 0o600); err != nil {
+			t.Fatalf("write fixture %s: %v", name, err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0o700); err != nil {
+		t.Fatalf("mkdir subdir: %v", err)
+	}
+
+	got, err := expandKubeconfigPaths(dir)
+	if err != nil {
+		t.Fatalf("expandKubeconfigPaths returned an unexpected error: %v", err)
+	}
+	sort.Strings(got)
+	want := []string{filepath.Join(dir, "cluster-a"), filepath.Join(dir, "cluster-b")}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v, want %v (subdirectories must be skipped)", got, want)
+	}
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/tpretz/k8mon/pkg/alert"
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+	clientset "github.com/tpretz/k8mon/pkg/generated/clientset/versioned"
+	monitorinformers "github.com/tpretz/k8mon/pkg/generated/informers/externalversions/k8mon/v1"
+	monitorlisters "github.com/tpretz/k8mon/pkg/generated/listers/k8mon/v1"
+)
+
+// maxRetries bounds how many times sync is retried for a key before it's
+// dropped from the queue.
+const maxRetries = 5
+
+// monitorController reconciles Monitor objects: namespace/name keys enqueued
+// from informer events are popped by a pool of workers, each of which starts,
+// restarts, or stops that Monitor's probe goroutine to match its current spec.
+type monitorController struct {
+	client         clientset.Interface
+	lister         monitorlisters.MonitorLister
+	notifierLister monitorlisters.NotifierLister
+	synced         cache.InformerSynced
+	queue          workqueue.RateLimitingInterface
+	clusterName    string
+	resolver       *serviceResolver
+	dispatcher     *alert.Dispatcher
+
+	mu   sync.Mutex
+	runs map[string]*monitorRun
+}
+
+// monitorRun tracks the goroutine and spec currently probing a Monitor.
+type monitorRun struct {
+	cancel context.CancelFunc
+	spec   k8monv1.MonitorSpec
+}
+
+// newMonitorController builds a controller for the Monitors served by
+// informer. clusterName labels every metric and event this controller's
+// probes emit; resolver resolves cluster/namespace/service targets, which
+// may reference any cluster k8mon watches, not just clusterName's.
+// notifierLister resolves a Monitor's spec.Notifiers to Notifier CRs, and
+// dispatcher delivers the resulting fire/resolve alerts.
+func newMonitorController(client clientset.Interface, informer monitorinformers.MonitorInformer, clusterName string, resolver *serviceResolver, notifierLister monitorlisters.NotifierLister, dispatcher *alert.Dispatcher) *monitorController {
+	c := &monitorController{
+		client:         client,
+		lister:         informer.Lister(),
+		notifierLister: notifierLister,
+		synced:         informer.Informer().HasSynced,
+		queue:          workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		clusterName:    clusterName,
+		resolver:       resolver,
+		dispatcher:     dispatcher,
+		runs:           make(map[string]*monitorRun),
+	}
+
+	informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) { c.enqueue(newObj) },
+		DeleteFunc: c.enqueue,
+	})
+
+	return c
+}
+
+func (c *monitorController) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run starts numWorkers workers and blocks until ctx is cancelled, at which
+// point every probe goroutine it started is cancelled too.
+func (c *monitorController) Run(ctx context.Context, numWorkers int) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced) {
+		return fmt.Errorf("failed to wait for Monitor cache to sync")
+	}
+
+	for i := 0; i < numWorkers; i++ {
+		go c.runWorker(ctx)
+	}
+
+	<-ctx.Done()
+
+	c.mu.Lock()
+	for key, run := range c.runs {
+		run.cancel()
+		delete(c.runs, key)
+	}
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *monitorController) runWorker(ctx context.Context) {
+	for c.processNextItem(ctx) {
+	}
+}
+
+func (c *monitorController) processNextItem(ctx context.Context) bool {
+	key, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.sync(ctx, key.(string))
+	c.handleErr(err, key)
+	return true
+}
+
+// handleErr forgets keys that synced cleanly and requeues failed ones with
+// the queue's exponential backoff, up to maxRetries.
+func (c *monitorController) handleErr(err error, key interface{}) {
+	if err == nil {
+		c.queue.Forget(key)
+		return
+	}
+
+	if c.queue.NumRequeues(key) < maxRetries {
+		fmt.Printf("monitor %v: sync error, retrying: %v\n", key, err)
+		c.queue.AddRateLimited(key)
+		return
+	}
+
+	c.queue.Forget(key)
+	utilruntime.HandleError(err)
+	fmt.Printf("monitor %v: dropping out of the queue after %d retries: %v\n", key, maxRetries, err)
+}
+
+// sync reconciles the Monitor named by key: a Monitor no longer in the
+// lister has its probe goroutine stopped, and a changed spec restarts the
+// goroutine with the new configuration. An unchanged spec is a no-op.
+func (c *monitorController) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return fmt.Errorf("invalid resource key %q: %w", key, err)
+	}
+
+	mon, err := c.lister.Monitors(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		c.stop(key)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get monitor %s: %w", key, err)
+	}
+
+	spec, err := effectiveSpec(mon.Spec)
+	if err != nil {
+		c.stop(key)
+		return fmt.Errorf("monitor %s: invalid spec: %w", key, err)
+	}
+
+	c.mu.Lock()
+	existing, running := c.runs[key]
+	c.mu.Unlock()
+	if running && reflect.DeepEqual(existing.spec, spec) {
+		return nil
+	}
+
+	c.restart(ctx, key, namespace, name, spec, int(mon.Status.ConsecutiveFailures))
+	return nil
+}
+
+// restart stops any probe goroutine already running for key and starts a
+// fresh one with spec. initialFailures seeds the new goroutine's
+// consecutive-failure counter from the Monitor's last observed status, so a
+// restart triggered by an unrelated spec edit, a leader-election failover,
+// or controller startup doesn't reset an already-Failing Monitor back to
+// Healthy/Degraded and fire a spurious de-escalation alert.
+func (c *monitorController) restart(ctx context.Context, key, namespace, name string, spec k8monv1.MonitorSpec, initialFailures int) {
+	c.stop(key)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	c.runs[key] = &monitorRun{cancel: cancel, spec: spec}
+	c.mu.Unlock()
+
+	go c.runProbeLoop(runCtx, namespace, name, spec, initialFailures)
+}
+
+// stop cancels and forgets the run for key, if any, and cleans up its
+// metrics labels.
+func (c *monitorController) stop(key string) {
+	c.mu.Lock()
+	run, ok := c.runs[key]
+	delete(c.runs, key)
+	c.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	run.cancel()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return
+	}
+	deleteProbeMetrics(c.clusterName, namespace, name, run.spec)
+}
+
+// runProbeLoop drives the probe loop for a single Monitor until ctx is
+// cancelled, probing once immediately and then every spec.Interval.
+// initialFailures seeds the consecutive-failure counter so a goroutine
+// restart doesn't masquerade as a health improvement; see restart's doc
+// comment.
+func (c *monitorController) runProbeLoop(ctx context.Context, namespace, name string, spec k8monv1.MonitorSpec, initialFailures int) {
+	key := namespace + "/" + name
+	failures := initialFailures
+
+	probeOnce := func() {
+		latency, err := runProbe(ctx, spec, c.resolver)
+		if err != nil {
+			failures++
+		} else {
+			failures = 0
+		}
+
+		result := probeResult{
+			success:             err == nil,
+			err:                 err,
+			latency:             latency,
+			consecutiveFailures: failures,
+		}
+		recordProbeMetrics(c.clusterName, namespace, name, spec, latency, result.success)
+
+		previousPhase, newPhase, updateErr := updateMonitorStatus(ctx, c.client, namespace, name, spec, result)
+		if updateErr != nil {
+			fmt.Printf("monitor %s: failed to update status: %v\n", key, updateErr)
+			return
+		}
+		if newPhase != previousPhase {
+			c.dispatchTransition(namespace, name, spec, newPhase, result)
+		}
+	}
+
+	probeOnce()
+
+	ticker := time.NewTicker(spec.Interval.Duration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			probeOnce()
+		}
+	}
+}
+
+// dispatchTransition fires or resolves every Notifier bound to a Monitor
+// via spec.Notifiers when its phase crosses into or out of Degraded or
+// Failing. All bound notifiers share result's dedup key: namespace/name.
+func (c *monitorController) dispatchTransition(namespace, name string, spec k8monv1.MonitorSpec, newPhase string, result probeResult) {
+	key := namespace + "/" + name
+
+	message := "probe succeeded"
+	if result.err != nil {
+		message = result.err.Error()
+	}
+	a := alert.Alert{
+		DedupKey: key,
+		Monitor:  key,
+		Phase:    newPhase,
+		Message:  message,
+		Time:     time.Now(),
+	}
+
+	for _, ref := range spec.Notifiers {
+		notifierCR, err := c.notifierLister.Notifiers(namespace).Get(ref.Name)
+		if err != nil {
+			fmt.Printf("monitor %s: notifier %s: %v\n", key, ref.Name, err)
+			continue
+		}
+
+		notifier, err := buildNotifier(notifierCR.Spec)
+		if err != nil {
+			fmt.Printf("monitor %s: notifier %s: %v\n", key, ref.Name, err)
+			continue
+		}
+
+		if newPhase == phaseHealthy {
+			c.dispatcher.Resolve(notifier, a)
+		} else {
+			c.dispatcher.Fire(notifier, a)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// leaderElectionOptions mirrors the --leader-elect* flags.
+type leaderElectionOptions struct {
+	enabled       bool
+	leaseDuration time.Duration
+	namespace     string
+	id            string
+}
+
+// runWithLeaderElection blocks running fn only while this process holds the
+// Lease named "k8mon-leader-election". Losing the lease cancels fn's
+// context so every probe goroutine it started exits cleanly; a new Lease
+// holder simply calls fn again from its own OnStartedLeading.
+func runWithLeaderElection(ctx context.Context, config *rest.Config, opts leaderElectionOptions, fn func(ctx context.Context)) error {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("build clientset for leader election: %w", err)
+	}
+
+	identity, err := leaderElectionIdentity(opts.id, os.Getenv("POD_NAME"), os.Hostname)
+	if err != nil {
+		return fmt.Errorf("determine leader election identity: %w", err)
+	}
+
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		opts.namespace,
+		"k8mon-leader-election",
+		clientset.CoreV1(),
+		clientset.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: identity},
+	)
+	if err != nil {
+		return fmt.Errorf("build leader election lock: %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	leaderelection.RunOrDie(runCtx, leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: opts.leaseDuration,
+		RenewDeadline: opts.leaseDuration * 2 / 3,
+		RetryPeriod:   opts.leaseDuration / 3,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				fmt.Printf("%s: acquired leader election, starting controller\n", identity)
+				fn(leaderCtx)
+			},
+			OnStoppedLeading: func() {
+				fmt.Printf("%s: lost leader election, stopping controller\n", identity)
+				cancel()
+			},
+		},
+	})
+
+	return nil
+}
+
+// leaderElectionIdentity picks the Lease holder identity: optsID if set,
+// else podName (the $POD_NAME downward-API env var), else whatever
+// hostname reports.
+func leaderElectionIdentity(optsID, podName string, hostname func() (string, error)) (string, error) {
+	if optsID != "" {
+		return optsID, nil
+	}
+	if podName != "" {
+		return podName, nil
+	}
+	return hostname()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLeaderElectionIdentityPrefersOptsID(t *testing.T) {
+	id, err := leaderElectionIdentity("explicit", "pod-name", func() (string, error) {
+		t.Fatal("hostname should not be called when optsID is set")
+		return "", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "explicit" {
+		t.Errorf("identity = %q, want %q", id, "explicit")
+	}
+}
+
+func TestLeaderElectionIdentityFallsBackToPodName(t *testing.T) {
+	id, err := leaderElectionIdentity("", "pod-name", func() (string, error) {
+		t.Fatal("hostname should not be called when podName is set")
+		return "", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "pod-name" {
+		t.Errorf("identity = %q, want %q", id, "pod-name")
+	}
+}
+
+func TestLeaderElectionIdentityFallsBackToHostname(t *testing.T) {
+	id, err := leaderElectionIdentity("", "", func() (string, error) {
+		return "some-host", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "some-host" {
+		t.Errorf("identity = %q, want %q", id, "some-host")
+	}
+}
+
+func TestLeaderElectionIdentityPropagatesHostnameError(t *testing.T) {
+	wantErr := errors.New("no hostname")
+	_, err := leaderElectionIdentity("", "", func() (string, error) {
+		return "", wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("err = %v, want %v", err, wantErr)
+	}
+}
@@ -2,103 +2,129 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"path/filepath"
+	"sync"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/watch"
-	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/rest"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/util/homedir"
+
+	"github.com/tpretz/k8mon/pkg/alert"
+	monitorclientset "github.com/tpretz/k8mon/pkg/generated/clientset/versioned"
+	monitorinformers "github.com/tpretz/k8mon/pkg/generated/informers/externalversions"
 )
 
-func main() {
+// alertWorkers is how many background goroutines deliver fire/resolve
+// events to Notifiers, shared across every cluster's controller.
+const alertWorkers = 2
 
-	// define a variable called "kubeconfig" to store the path to the kubeconfig file
-	var kubeconfig string
+// numWorkers is how many goroutines reconcile Monitors off the workqueue,
+// per cluster.
+const numWorkers = 2
 
-	// check if home directory path is not empty.
-	// if not, contruct the path to kubeconfig file
-	if home := homedir.HomeDir(); home != "" {
-		kubeconfig = filepath.Join(home, ".kube", "config")
-	}
+func main() {
 
-	// build configuration to connect to a K8s cluster based on command-line flags and provided kubeconfig path
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	metricsAddr := flag.String("metrics-addr", ":9090", "address to serve Prometheus /metrics on")
+	namespace := flag.String("namespace", "", "namespace to watch Monitors in (empty watches all namespaces)")
+	labelSelector := flag.String("label-selector", "", "label selector used to filter which Monitors are watched")
+	resyncPeriod := flag.Duration("resync-period", 0, "how often the informer cache resyncs (0 disables periodic resync)")
+	kubeconfigs := flag.String("kubeconfigs", "", "comma-separated kubeconfig file paths, or a directory containing one kubeconfig per cluster, to monitor; empty watches the in-cluster/local kubeconfig's cluster only")
+	leaderElect := flag.Bool("leader-elect", false, "run multiple replicas safely by electing a single leader to probe Monitors")
+	leaderElectLeaseDuration := flag.Duration("leader-elect-lease-duration", 15*time.Second, "duration non-leader candidates wait before trying to acquire the lease")
+	leaderElectNamespace := flag.String("leader-elect-namespace", "default", "namespace to create the leader election Lease in")
+	leaderElectID := flag.String("leader-elect-id", "", "leader election holder identity (defaults to $POD_NAME, then hostname)")
+	flag.Parse()
 
-	// if external kubeconfig file either wasn't found, wasn't accessible, or was invalid
-	// throw error
-	if err != nil {
-		fmt.Println("Falling back to in-cluster config")
+	serveMetrics(*metricsAddr)
 
-		// retrieve configuration from environment variables and service account tokens available within pod
-		config, err = rest.InClusterConfig()
+	clusters, err := loadClusterConfigs(*kubeconfigs)
+	if err != nil {
+		panic(err.Error())
+	}
 
-		// if even the in-cluster configuration setup fails, raise panic
+	kubeClients := make(map[string]kubernetes.Interface, len(clusters))
+	monitorClients := make(map[string]monitorclientset.Interface, len(clusters))
+	for _, cl := range clusters {
+		kubeClient, err := kubernetes.NewForConfig(cl.restConfig)
+		if err != nil {
+			panic(err.Error())
+		}
+		monitorClient, err := monitorclientset.NewForConfig(cl.restConfig)
 		if err != nil {
 			panic(err.Error())
 		}
+		kubeClients[cl.name] = kubeClient
+		monitorClients[cl.name] = monitorClient
 	}
-	// create new dynamic client for interacting with K8s API
-	dynClient, err := dynamic.NewForConfig(config)
-	if err != nil {
+
+	// resolver is shared across every cluster's controller so a Monitor
+	// defined in one cluster can still probe a Service in another.
+	resolver := newServiceResolver(kubeClients)
+
+	runControllers := func(ctx context.Context) {
+		dispatcher := alert.NewDispatcher(ctx, alertWorkers)
+
+		var wg sync.WaitGroup
+		for _, cl := range clusters {
+			cl := cl
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				runMonitorControllers(ctx, cl.name, monitorClients[cl.name], resolver, dispatcher, *namespace, *labelSelector, *resyncPeriod)
+			}()
+		}
+		wg.Wait()
+	}
+
+	if !*leaderElect {
+		runControllers(context.Background())
+		return
+	}
+
+	// The leader election Lease lives in the first configured cluster
+	// (the "local" one when --kubeconfigs is empty); a single leader
+	// across all replicas still probes every cluster k8mon watches.
+	opts := leaderElectionOptions{
+		enabled:       *leaderElect,
+		leaseDuration: *leaderElectLeaseDuration,
+		namespace:     *leaderElectNamespace,
+		id:            *leaderElectID,
+	}
+	if err := runWithLeaderElection(context.Background(), clusters[0].restConfig, opts, runControllers); err != nil {
 		panic(err.Error())
 	}
+}
 
-	// define variable, "thefoothebar" holding definition of custom resource
-	// specifies that resource belongs to API group "myk8s.io", with version "v1", & plural name of resource
-	monitors := schema.GroupVersionResource{Group: "k8mon.tpretz.com", Version: "v1", Resource: "monitors"}
-
-	// creates new informer for specific resource i.e. "thefoothebar"
-	// this informer watches for changes to resource & maintains a local cache of all resources of this type
-	informer := cache.NewSharedIndexInformer(
-		// callbacks defining how to list and watch the resources, respectively
-		&cache.ListWatch{
-			// ListFunc initially populates informer's cache
-			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				return dynClient.Resource(monitors).Namespace("").List(context.TODO(), options)
-			},
-			// WatchFunc keeps cache updated with any changes
-			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return dynClient.Resource(monitors).Namespace("").Watch(context.TODO(), options)
-			},
-		},
-
-		// specifies that informer is for unstructured data
-		// unstructured data represent any K8s resource without needing a predefined struct
-		&unstructured.Unstructured{},
-
-		// resync period of 0 means that informer will not resync the resources unless explicitly triggered
-		0,
-		cache.Indexers{},
-	)
-
-	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj interface{}) {
-			fmt.Printf("New monitor added: %s\n", obj)
-		},
-		UpdateFunc: func(oldObj, newObj interface{}) {
-			fmt.Printf("Monitor updated: %s\n", newObj)
-		},
-		DeleteFunc: func(obj interface{}) {
-			fmt.Printf("Monitor deleted: %s\n", obj)
-		},
-	})
-
-	// starts the informer
-	stop := make(chan struct{})
-	defer close(stop)
-	go informer.Run(stop)
-
-	// wait for the informer's cache to sync
-	if !cache.WaitForCacheSync(stop, informer.HasSynced) {
-		panic("failed to sync")
+// runMonitorControllers wires up the Monitors informer (typed, generated
+// from pkg/apis/k8mon/v1), then runs the workqueue-based Monitor controller
+// for a single cluster until ctx is cancelled. Called directly, or from
+// OnStartedLeading when --leader-elect is set, once per configured cluster.
+func runMonitorControllers(ctx context.Context, clusterName string, monitorClient monitorclientset.Interface, resolver *serviceResolver, dispatcher *alert.Dispatcher, namespace, labelSelector string, resyncPeriod time.Duration) {
+	// tweakListOptions applies --label-selector to every list/watch call
+	// the factory's informers make, so large clusters don't cache Monitors
+	// the operator never asked for.
+	tweakListOptions := func(options *metav1.ListOptions) {
+		if labelSelector != "" {
+			options.LabelSelector = labelSelector
+		}
 	}
 
-	<-stop
+	monitorFactory := monitorinformers.NewFilteredSharedInformerFactory(monitorClient, resyncPeriod, namespace, tweakListOptions)
+	monitorInformer := monitorFactory.K8mon().V1().Monitors()
+	notifierInformer := monitorFactory.K8mon().V1().Notifiers()
+
+	controller := newMonitorController(monitorClient, monitorInformer, clusterName, resolver, notifierInformer.Lister(), dispatcher)
+
+	stop := ctx.Done()
+	monitorFactory.Start(stop)
+
+	if !cache.WaitForCacheSync(stop, monitorInformer.Informer().HasSynced, notifierInformer.Informer().HasSynced) {
+		panic(fmt.Sprintf("cluster %s: failed to sync informer caches", clusterName))
+	}
+
+	if err := controller.Run(ctx, numWorkers); err != nil {
+		fmt.Printf("cluster %s: monitor controller exited: %v\n", clusterName, err)
+	}
 }
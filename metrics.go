@@ -0,0 +1,88 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+var (
+	probeSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "k8mon_probe_success",
+		Help: "Whether the most recent probe for a Monitor succeeded (1) or not (0).",
+	}, []string{"cluster", "monitor", "namespace", "type", "target"})
+
+	probeDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "k8mon_probe_duration_seconds",
+		Help: "Observed durations of Monitor probes.",
+	}, []string{"cluster", "monitor", "namespace", "type", "target"})
+
+	probeTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "k8mon_probe_total",
+		Help: "Total number of probes run for a Monitor.",
+	}, []string{"cluster", "monitor", "namespace", "type", "target"})
+
+	probeFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "k8mon_probe_failures_total",
+		Help: "Total number of failed probes for a Monitor.",
+	}, []string{"cluster", "monitor", "namespace", "type", "target"})
+)
+
+func init() {
+	prometheus.MustRegister(probeSuccess, probeDurationSeconds, probeTotal, probeFailuresTotal)
+}
+
+// serveMetrics starts the Prometheus /metrics endpoint in the background.
+// It does not block; errors are logged since a dead metrics server
+// shouldn't take down probing.
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Printf("metrics server exited: %v", err)
+		}
+	}()
+}
+
+func probeLabels(cluster, namespace, name string, spec k8monv1.MonitorSpec) prometheus.Labels {
+	return prometheus.Labels{
+		"cluster":   cluster,
+		"monitor":   name,
+		"namespace": namespace,
+		"type":      string(spec.Type),
+		"target":    spec.Target,
+	}
+}
+
+func recordProbeMetrics(cluster, namespace, name string, spec k8monv1.MonitorSpec, latency time.Duration, success bool) {
+	labels := probeLabels(cluster, namespace, name, spec)
+
+	successValue := 0.0
+	if success {
+		successValue = 1.0
+	}
+	probeSuccess.With(labels).Set(successValue)
+	probeDurationSeconds.With(labels).Observe(latency.Seconds())
+	probeTotal.With(labels).Inc()
+	if !success {
+		probeFailuresTotal.With(labels).Inc()
+	}
+}
+
+// deleteProbeMetrics removes every series for a Monitor so a deleted
+// Monitor doesn't linger in /metrics output forever. Label values must be
+// passed in the same order the metrics were declared with: cluster,
+// monitor, namespace, type, target.
+func deleteProbeMetrics(cluster, namespace, name string, spec k8monv1.MonitorSpec) {
+	probeSuccess.DeleteLabelValues(cluster, name, namespace, string(spec.Type), spec.Target)
+	probeDurationSeconds.DeleteLabelValues(cluster, name, namespace, string(spec.Type), spec.Target)
+	probeTotal.DeleteLabelValues(cluster, name, namespace, string(spec.Type), spec.Target)
+	probeFailuresTotal.DeleteLabelValues(cluster, name, namespace, string(spec.Type), spec.Target)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+func TestProbeLabels(t *testing.T) {
+	spec := k8monv1.MonitorSpec{Type: k8monv1.MonitorProtocolHTTP, Target: "http://example.com"}
+	got := probeLabels("prod", "default", "homepage", spec)
+	want := prometheus.Labels{
+		"cluster":   "prod",
+		"monitor":   "homepage",
+		"namespace": "default",
+		"type":      "http",
+		"target":    "http://example.com",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("probeLabels()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("probeLabels() has %d labels, want %d", len(got), len(want))
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/tpretz/k8mon/pkg/alert"
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+// buildNotifier turns a Notifier CR's spec into the alert.Notifier backend
+// it configures.
+func buildNotifier(spec k8monv1.NotifierSpec) (alert.Notifier, error) {
+	switch spec.Type {
+	case k8monv1.NotifierTypeWebhook:
+		if spec.Webhook == nil {
+			return nil, fmt.Errorf("notifier type %q requires spec.webhook", spec.Type)
+		}
+		return alert.NewWebhookNotifier(spec.Webhook.URL), nil
+	case k8monv1.NotifierTypeSlack:
+		if spec.Slack == nil {
+			return nil, fmt.Errorf("notifier type %q requires spec.slack", spec.Type)
+		}
+		return alert.NewSlackNotifier(spec.Slack.WebhookURL), nil
+	case k8monv1.NotifierTypePagerDuty:
+		if spec.PagerDuty == nil {
+			return nil, fmt.Errorf("notifier type %q requires spec.pagerDuty", spec.Type)
+		}
+		return alert.NewPagerDutyNotifier(spec.PagerDuty.IntegrationKey), nil
+	case k8monv1.NotifierTypeSMTP:
+		if spec.SMTP == nil {
+			return nil, fmt.Errorf("notifier type %q requires spec.smtp", spec.Type)
+		}
+		return alert.NewSMTPNotifier(spec.SMTP.Host, spec.SMTP.From, spec.SMTP.To), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q", spec.Type)
+	}
+}
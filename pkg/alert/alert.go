@@ -0,0 +1,32 @@
+// Package alert dispatches Monitor health transitions to pluggable
+// notification backends.
+package alert
+
+import (
+	"context"
+	"time"
+)
+
+// Alert is a single fire or resolve event dispatched to a Notifier.
+type Alert struct {
+	// DedupKey identifies the alerting Monitor as "namespace/name", letting
+	// a Notifier collapse repeated fires/resolves for the same Monitor.
+	DedupKey string
+	// Monitor is the "namespace/name" of the Monitor that raised this alert.
+	Monitor string
+	// Phase is the Monitor's health phase at the time of this event:
+	// Degraded or Failing for a fire, Healthy for a resolve.
+	Phase string
+	// Message is a human-readable summary of why the alert fired.
+	Message string
+	// Time is when the alert was raised.
+	Time time.Time
+}
+
+// Notifier dispatches Monitor health transitions to an alert destination.
+// Fire is called when a Monitor becomes Degraded or Failing; Resolve is
+// called when it returns to Healthy.
+type Notifier interface {
+	Fire(ctx context.Context, a Alert) error
+	Resolve(ctx context.Context, a Alert) error
+}
@@ -0,0 +1,110 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// queueSize bounds how many pending dispatch jobs a Dispatcher holds. A
+// slow or unreachable Notifier must delay dispatch, never the probe loop
+// that enqueues Fire/Resolve calls.
+const queueSize = 256
+
+// maxAttempts bounds how many times a dispatch job is retried before it's
+// dropped.
+const maxAttempts = 5
+
+// defaultBaseBackoff is the delay before the first retry; each subsequent
+// retry doubles it. Dispatcher.baseBackoff defaults to this and is only
+// overridden by tests that need a shorter schedule than real deliveries do.
+const defaultBaseBackoff = time.Second
+
+type job struct {
+	notifier Notifier
+	alert    Alert
+	fire     bool
+}
+
+// Dispatcher runs Fire/Resolve calls to Notifiers on background workers,
+// off a bounded queue, retrying failed deliveries with exponential
+// backoff. The zero value is not usable; construct with NewDispatcher.
+type Dispatcher struct {
+	jobs        chan job
+	baseBackoff time.Duration
+}
+
+// NewDispatcher starts a Dispatcher with numWorkers background workers
+// that run until ctx is cancelled.
+func NewDispatcher(ctx context.Context, numWorkers int) *Dispatcher {
+	d := &Dispatcher{jobs: make(chan job, queueSize), baseBackoff: defaultBaseBackoff}
+	for i := 0; i < numWorkers; i++ {
+		go d.run(ctx)
+	}
+	return d
+}
+
+// Fire enqueues a fire event for notifier. If the queue is full the event
+// is dropped rather than blocking the caller.
+func (d *Dispatcher) Fire(notifier Notifier, a Alert) {
+	d.enqueue(job{notifier: notifier, alert: a, fire: true})
+}
+
+// Resolve enqueues a resolve event for notifier. If the queue is full the
+// event is dropped rather than blocking the caller.
+func (d *Dispatcher) Resolve(notifier Notifier, a Alert) {
+	d.enqueue(job{notifier: notifier, alert: a, fire: false})
+}
+
+func (d *Dispatcher) enqueue(j job) {
+	select {
+	case d.jobs <- j:
+	default:
+		fmt.Printf("alert dispatcher: queue full, dropping %s for %s\n", eventName(j.fire), j.alert.DedupKey)
+	}
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-d.jobs:
+			d.deliver(ctx, j)
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, j job) {
+	backoff := d.baseBackoff
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var err error
+		if j.fire {
+			err = j.notifier.Fire(ctx, j.alert)
+		} else {
+			err = j.notifier.Resolve(ctx, j.alert)
+		}
+		if err == nil {
+			return
+		}
+
+		fmt.Printf("alert dispatcher: %s %s failed (attempt %d/%d): %v\n", eventName(j.fire), j.alert.DedupKey, attempt, maxAttempts, err)
+		if attempt == maxAttempts {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func eventName(fire bool) string {
+	if fire {
+		return "fire"
+	}
+	return "resolve"
+}
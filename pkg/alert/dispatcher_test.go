@@ -0,0 +1,127 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingNotifier records every Fire/Resolve call and fails the first
+// failCount calls to either method before succeeding.
+type countingNotifier struct {
+	mu        sync.Mutex
+	failCount int
+	calls     int
+}
+
+func (n *countingNotifier) Fire(ctx context.Context, a Alert) error {
+	return n.call()
+}
+
+func (n *countingNotifier) Resolve(ctx context.Context, a Alert) error {
+	return n.call()
+}
+
+func (n *countingNotifier) call() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.calls++
+	if n.calls <= n.failCount {
+		return errTemporaryFailure
+	}
+	return nil
+}
+
+func (n *countingNotifier) callCount() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.calls
+}
+
+var errTemporaryFailure = &testError{"temporary failure"}
+
+type testError struct{ msg string }
+
+func (e *testError) Error() string { return e.msg }
+
+// testBackoff is the backoff TestDispatcherRetriesFailedDelivery and
+// TestDispatcherGivesUpAfterMaxAttempts seed their Dispatcher with, so they
+// exercise the real retry/backoff logic without paying defaultBaseBackoff's
+// real wall-clock delay.
+const testBackoff = time.Millisecond
+
+func TestDispatcherDeliversFire(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewDispatcher(ctx, 1)
+	n := &countingNotifier{}
+	d.Fire(n, Alert{DedupKey: "ns/mon"})
+
+	deadline := time.Now().Add(time.Second)
+	for n.callCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := n.callCount(); got != 1 {
+		t.Fatalf("notifier called %d times, want 1", got)
+	}
+}
+
+func TestDispatcherRetriesFailedDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewDispatcher(ctx, 1)
+	d.baseBackoff = testBackoff
+	n := &countingNotifier{failCount: 1}
+	d.Fire(n, Alert{DedupKey: "ns/mon"})
+
+	deadline := time.Now().Add(time.Second)
+	for n.callCount() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := n.callCount(); got != 2 {
+		t.Fatalf("notifier called %d times, want 2 (one failure, one successful retry)", got)
+	}
+}
+
+func TestDispatcherGivesUpAfterMaxAttempts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewDispatcher(ctx, 1)
+	d.baseBackoff = testBackoff
+	n := &countingNotifier{failCount: maxAttempts + 5}
+	d.Fire(n, Alert{DedupKey: "ns/mon"})
+
+	deadline := time.Now().Add(time.Second)
+	for n.callCount() < maxAttempts && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := n.callCount(); got != maxAttempts {
+		t.Fatalf("notifier called %d times, want exactly %d (maxAttempts)", got, maxAttempts)
+	}
+}
+
+func TestDispatcherDropsWhenQueueFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// No workers drain the queue, so it fills to queueSize and the next
+	// enqueue must be dropped rather than block the caller.
+	d := NewDispatcher(ctx, 0)
+	n := &countingNotifier{}
+
+	for i := 0; i < queueSize; i++ {
+		d.Fire(n, Alert{DedupKey: "ns/mon"})
+	}
+	if got := len(d.jobs); got != queueSize {
+		t.Fatalf("queue length = %d, want %d after filling to capacity", got, queueSize)
+	}
+
+	d.Fire(n, Alert{DedupKey: "ns/mon"}) // must not block
+	if got := len(d.jobs); got != queueSize {
+		t.Fatalf("queue length = %d, want %d; overflow enqueue should have been dropped", got, queueSize)
+	}
+}
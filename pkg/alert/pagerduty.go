@@ -0,0 +1,91 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// pagerDutyEventsURL is the PagerDuty Events API v2 ingestion endpoint.
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyNotifier dispatches fire/resolve events through the PagerDuty
+// Events API v2, using DedupKey as the PagerDuty dedup_key so a resolve
+// closes out the matching fire.
+type PagerDutyNotifier struct {
+	IntegrationKey string
+	Client         *http.Client
+}
+
+// NewPagerDutyNotifier builds a PagerDutyNotifier for the given Events API
+// v2 integration (routing) key.
+func NewPagerDutyNotifier(integrationKey string) *PagerDutyNotifier {
+	return &PagerDutyNotifier{IntegrationKey: integrationKey, Client: http.DefaultClient}
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary  string `json:"summary"`
+	Source   string `json:"source"`
+	Severity string `json:"severity"`
+}
+
+func (n *PagerDutyNotifier) Fire(ctx context.Context, a Alert) error {
+	return n.send(ctx, pagerDutyEvent{
+		RoutingKey:  n.IntegrationKey,
+		EventAction: "trigger",
+		DedupKey:    a.DedupKey,
+		Payload: &pagerDutyPayload{
+			Summary:  fmt.Sprintf("%s is %s: %s", a.Monitor, a.Phase, a.Message),
+			Source:   a.Monitor,
+			Severity: pagerDutySeverity(a.Phase),
+		},
+	})
+}
+
+func (n *PagerDutyNotifier) Resolve(ctx context.Context, a Alert) error {
+	return n.send(ctx, pagerDutyEvent{
+		RoutingKey:  n.IntegrationKey,
+		EventAction: "resolve",
+		DedupKey:    a.DedupKey,
+	})
+}
+
+func pagerDutySeverity(phase string) string {
+	if phase == "Failing" {
+		return "critical"
+	}
+	return "warning"
+}
+
+func (n *PagerDutyNotifier) send(ctx context.Context, event pagerDutyEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal pagerduty event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pagerDutyEventsURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build pagerduty request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("pagerduty request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("pagerduty returned status %d", resp.StatusCode)
+	}
+	return nil
+}
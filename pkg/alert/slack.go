@@ -0,0 +1,57 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SlackNotifier posts a message to a Slack incoming webhook for every fire
+// and resolve event.
+type SlackNotifier struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+// NewSlackNotifier builds a SlackNotifier posting to webhookURL.
+func NewSlackNotifier(webhookURL string) *SlackNotifier {
+	return &SlackNotifier{WebhookURL: webhookURL, Client: http.DefaultClient}
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (n *SlackNotifier) Fire(ctx context.Context, a Alert) error {
+	return n.post(ctx, fmt.Sprintf(":rotating_light: *%s* is %s: %s", a.Monitor, a.Phase, a.Message))
+}
+
+func (n *SlackNotifier) Resolve(ctx context.Context, a Alert) error {
+	return n.post(ctx, fmt.Sprintf(":white_check_mark: *%s* recovered: %s", a.Monitor, a.Message))
+}
+
+func (n *SlackNotifier) post(ctx context.Context, text string) error {
+	body, err := json.Marshal(slackMessage{Text: text})
+	if err != nil {
+		return fmt.Errorf("marshal slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("slack request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPNotifier emails fire/resolve events through an SMTP relay.
+type SMTPNotifier struct {
+	Host string
+	From string
+	To   []string
+}
+
+// NewSMTPNotifier builds an SMTPNotifier relaying through host (host:port),
+// sending as from to the given recipients.
+func NewSMTPNotifier(host, from string, to []string) *SMTPNotifier {
+	return &SMTPNotifier{Host: host, From: from, To: to}
+}
+
+func (n *SMTPNotifier) Fire(ctx context.Context, a Alert) error {
+	return n.send(ctx, fmt.Sprintf("[k8mon] %s is %s", a.Monitor, a.Phase), a.Message)
+}
+
+func (n *SMTPNotifier) Resolve(ctx context.Context, a Alert) error {
+	return n.send(ctx, fmt.Sprintf("[k8mon] %s recovered", a.Monitor), a.Message)
+}
+
+// send runs smtp.SendMail, which has no context support of its own, on a
+// background goroutine, returning as soon as either it finishes or ctx is
+// done. If ctx wins, the goroutine is left to finish or fail on its own
+// rather than blocking one of the Dispatcher's workers indefinitely.
+func (n *SMTPNotifier) send(ctx context.Context, subject, body string) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		n.From, strings.Join(n.To, ", "), subject, body)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(n.Host, nil, n.From, n.To, []byte(msg))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("send mail via %s: %w", n.Host, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
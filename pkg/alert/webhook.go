@@ -0,0 +1,58 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// WebhookNotifier POSTs a JSON-encoded Alert to a URL for every fire and
+// resolve event.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookNotifier builds a WebhookNotifier posting to url.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{URL: url, Client: http.DefaultClient}
+}
+
+type webhookPayload struct {
+	Event string `json:"event"`
+	Alert Alert  `json:"alert"`
+}
+
+func (n *WebhookNotifier) Fire(ctx context.Context, a Alert) error {
+	return n.post(ctx, webhookPayload{Event: "fire", Alert: a})
+}
+
+func (n *WebhookNotifier) Resolve(ctx context.Context, a Alert) error {
+	return n.post(ctx, webhookPayload{Event: "resolve", Alert: a})
+}
+
+func (n *WebhookNotifier) post(ctx context.Context, payload webhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
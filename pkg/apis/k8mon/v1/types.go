@@ -0,0 +1,180 @@
+// Package v1 contains the typed API for the k8mon.tpretz.com/v1 group:
+// Monitor and Notifier.
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Monitor is a declarative probe: what to check (spec), and what was last
+// observed (status).
+type Monitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MonitorSpec   `json:"spec"`
+	Status MonitorStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MonitorList is a list of Monitors.
+type MonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Monitor `json:"items"`
+}
+
+// MonitorProtocol is the probe protocol a Monitor runs.
+type MonitorProtocol string
+
+const (
+	MonitorProtocolHTTP MonitorProtocol = "http"
+	MonitorProtocolTCP  MonitorProtocol = "tcp"
+	MonitorProtocolICMP MonitorProtocol = "icmp"
+)
+
+// MonitorSpec is the desired probing behavior for a Monitor.
+type MonitorSpec struct {
+	// Type selects the probe protocol: http, tcp, or icmp.
+	Type MonitorProtocol `json:"type"`
+	// Target is the probed endpoint: a URL for http, or host:port for tcp,
+	// or a hostname/IP for icmp.
+	Target string `json:"target"`
+	// Interval is how often the probe runs. Defaults to 30s.
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+	// Timeout bounds a single probe attempt. Defaults to 5s.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// ExpectedStatus is the HTTP status code a successful http probe must
+	// return. Ignored for tcp and icmp.
+	// +optional
+	ExpectedStatus int32 `json:"expectedStatus,omitempty"`
+	// ExpectedBody is a substring a successful http probe's response body
+	// must contain. Ignored for tcp and icmp.
+	// +optional
+	ExpectedBody string `json:"expectedBody,omitempty"`
+	// DegradedThreshold is how many consecutive failed probes move this
+	// Monitor from Healthy to Degraded. Defaults to 1.
+	// +optional
+	DegradedThreshold int32 `json:"degradedThreshold,omitempty"`
+	// FailingThreshold is how many consecutive failed probes move this
+	// Monitor from Degraded to Failing. Defaults to 3.
+	// +optional
+	FailingThreshold int32 `json:"failingThreshold,omitempty"`
+	// Notifiers lists the Notifiers to dispatch fire/resolve alerts to as
+	// this Monitor's health changes.
+	// +optional
+	Notifiers []NotifierRef `json:"notifiers,omitempty"`
+}
+
+// NotifierRef references a Notifier in the same namespace as the Monitor
+// that refers to it.
+type NotifierRef struct {
+	// Name is the referenced Notifier's name.
+	Name string `json:"name"`
+}
+
+// MonitorStatus is the last observed state of a Monitor's probing.
+type MonitorStatus struct {
+	// Phase summarizes the Monitor's health: Healthy, Degraded, or Failing.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// LastProbeTime is when the probe that produced this status ran.
+	// +optional
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// ConsecutiveFailures counts failed probes since the last success.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// LatencyMs is the duration of the last probe, in milliseconds.
+	// +optional
+	LatencyMs int64 `json:"latencyMs,omitempty"`
+	// Conditions follows the standard Kubernetes condition schema; it
+	// currently carries a single "Ready" condition.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Notifier is a declarative alert destination that Monitors dispatch
+// fire/resolve events to, bound via MonitorSpec.Notifiers.
+type Notifier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NotifierSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotifierList is a list of Notifiers.
+type NotifierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Notifier `json:"items"`
+}
+
+// NotifierType selects which backend a Notifier dispatches through.
+type NotifierType string
+
+const (
+	NotifierTypeWebhook   NotifierType = "webhook"
+	NotifierTypeSlack     NotifierType = "slack"
+	NotifierTypePagerDuty NotifierType = "pagerduty"
+	NotifierTypeSMTP      NotifierType = "smtp"
+)
+
+// NotifierSpec configures one alert destination. Only the field matching
+// Type is read; the others are ignored.
+type NotifierSpec struct {
+	// Type selects the notifier backend: webhook, slack, pagerduty, or smtp.
+	Type NotifierType `json:"type"`
+	// Webhook configures the webhook backend.
+	// +optional
+	Webhook *WebhookNotifierConfig `json:"webhook,omitempty"`
+	// Slack configures the slack backend.
+	// +optional
+	Slack *SlackNotifierConfig `json:"slack,omitempty"`
+	// PagerDuty configures the pagerduty backend.
+	// +optional
+	PagerDuty *PagerDutyNotifierConfig `json:"pagerDuty,omitempty"`
+	// SMTP configures the smtp backend.
+	// +optional
+	SMTP *SMTPNotifierConfig `json:"smtp,omitempty"`
+}
+
+// WebhookNotifierConfig configures the generic webhook backend.
+type WebhookNotifierConfig struct {
+	// URL receives a JSON POST for every fire and resolve event.
+	URL string `json:"url"`
+}
+
+// SlackNotifierConfig configures the Slack backend.
+type SlackNotifierConfig struct {
+	// WebhookURL is a Slack incoming webhook URL.
+	WebhookURL string `json:"webhookURL"`
+}
+
+// PagerDutyNotifierConfig configures the PagerDuty Events API v2 backend.
+type PagerDutyNotifierConfig struct {
+	// IntegrationKey is the PagerDuty Events API v2 routing key.
+	IntegrationKey string `json:"integrationKey"`
+}
+
+// SMTPNotifierConfig configures the SMTP backend.
+type SMTPNotifierConfig struct {
+	// Host is the SMTP server address, as host:port.
+	Host string `json:"host"`
+	// From is the envelope and header From address.
+	From string `json:"from"`
+	// To lists the recipient addresses.
+	To []string `json:"to"`
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+// runProbe executes a single probe against spec.Target according to
+// spec.Type and reports how long it took and whether it succeeded.
+// spec.Target is resolved through resolver first, so a
+// "cluster/namespace/service" tuple is probed by ClusterIP instead of
+// literally.
+func runProbe(ctx context.Context, spec k8monv1.MonitorSpec, resolver *serviceResolver) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, spec.Timeout.Duration)
+	defer cancel()
+
+	start := time.Now()
+	target, err := resolver.resolveTarget(ctx, spec.Type, spec.Target)
+	if err != nil {
+		return time.Since(start), fmt.Errorf("resolve target: %w", err)
+	}
+	spec.Target = target
+
+	switch spec.Type {
+	case k8monv1.MonitorProtocolHTTP:
+		err = probeHTTP(ctx, spec)
+	case k8monv1.MonitorProtocolTCP:
+		err = probeTCP(ctx, spec)
+	case k8monv1.MonitorProtocolICMP:
+		err = probeICMP(ctx, spec)
+	default:
+		err = fmt.Errorf("unknown monitor type %q", spec.Type)
+	}
+	return time.Since(start), err
+}
+
+func probeHTTP(ctx context.Context, spec k8monv1.MonitorSpec) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, spec.Target, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if spec.ExpectedStatus != 0 && int32(resp.StatusCode) != spec.ExpectedStatus {
+		return fmt.Errorf("got status %d, expected %d", resp.StatusCode, spec.ExpectedStatus)
+	}
+
+	if spec.ExpectedBody != "" {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("read body: %w", err)
+		}
+		if !strings.Contains(string(body), spec.ExpectedBody) {
+			return fmt.Errorf("response body did not contain %q", spec.ExpectedBody)
+		}
+	}
+
+	return nil
+}
+
+func probeTCP(ctx context.Context, spec k8monv1.MonitorSpec) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", spec.Target)
+	if err != nil {
+		return fmt.Errorf("dial failed: %w", err)
+	}
+	return conn.Close()
+}
+
+// probeICMP sends a single ICMP echo request and waits for the matching
+// reply. It requires CAP_NET_RAW (or running as root) to open the raw
+// socket, same as the system ping binary.
+func probeICMP(ctx context.Context, spec k8monv1.MonitorSpec) error {
+	conn, err := icmp.ListenPacket("ip4:icmp", "0.0.0.0")
+	if err != nil {
+		return fmt.Errorf("open icmp socket: %w", err)
+	}
+	defer conn.Close()
+
+	dst, err := net.ResolveIPAddr("ip4", spec.Target)
+	if err != nil {
+		return fmt.Errorf("resolve target: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("set deadline: %w", err)
+		}
+	}
+
+	wantID := os.Getpid() & 0xffff
+	const wantSeq = 1
+
+	msg := icmp.Message{
+		Type: ipv4.ICMPTypeEcho,
+		Code: 0,
+		Body: &icmp.Echo{
+			ID:   wantID,
+			Seq:  wantSeq,
+			Data: []byte("k8mon"),
+		},
+	}
+	wb, err := msg.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("marshal echo request: %w", err)
+	}
+	if _, err := conn.WriteTo(wb, dst); err != nil {
+		return fmt.Errorf("send echo request: %w", err)
+	}
+
+	// A raw ip4:icmp socket receives every ICMP packet arriving at the
+	// host, not just replies to this probe, and every concurrent ICMP
+	// Monitor in this process shares the same echo ID (os.Getpid()).
+	// Keep reading until we see a reply from dst matching our ID/Seq;
+	// conn.SetDeadline above bounds the loop to spec.Timeout.
+	rb := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(rb)
+		if err != nil {
+			return fmt.Errorf("read echo reply: %w", err)
+		}
+		if peer.String() != dst.String() {
+			continue
+		}
+
+		rm, err := icmp.ParseMessage(1, rb[:n])
+		if err != nil {
+			return fmt.Errorf("parse echo reply: %w", err)
+		}
+		if rm.Type != ipv4.ICMPTypeEchoReply {
+			continue
+		}
+
+		echo, ok := rm.Body.(*icmp.Echo)
+		if !ok || echo.ID != wantID || echo.Seq != wantSeq {
+			continue
+		}
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+// serviceResolver rewrites Monitor spec targets written as a
+// "cluster/namespace/service" tuple into an address this control plane can
+// actually probe, by looking up the named Service's ClusterIP in the named
+// cluster. Targets that aren't tuples are returned unchanged, so this is
+// safe to run over every probe regardless of how many clusters are
+// configured.
+type serviceResolver struct {
+	clients map[string]kubernetes.Interface
+}
+
+func newServiceResolver(clients map[string]kubernetes.Interface) *serviceResolver {
+	return &serviceResolver{clients: clients}
+}
+
+// resolveTarget resolves target for protocol, substituting a
+// "cluster/namespace/service[:port]" tuple with the Service's ClusterIP.
+func (r *serviceResolver) resolveTarget(ctx context.Context, protocol k8monv1.MonitorProtocol, target string) (string, error) {
+	clusterName, namespace, serviceAndPort, ok := splitServiceTuple(target)
+	if !ok {
+		return target, nil
+	}
+
+	client, ok := r.clients[clusterName]
+	if !ok {
+		return "", fmt.Errorf("target %q references unknown cluster %q", target, clusterName)
+	}
+
+	serviceName, port := serviceAndPort, ""
+	if idx := strings.LastIndex(serviceAndPort, ":"); idx != -1 {
+		serviceName, port = serviceAndPort[:idx], serviceAndPort[idx+1:]
+	}
+
+	svc, err := client.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", target, err)
+	}
+	if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		return "", fmt.Errorf("resolve %s: service has no ClusterIP", target)
+	}
+
+	address := svc.Spec.ClusterIP
+	if port != "" {
+		address = net.JoinHostPort(address, port)
+	}
+	if protocol == k8monv1.MonitorProtocolHTTP {
+		address = "http://" + address
+	}
+	return address, nil
+}
+
+// splitServiceTuple splits a "cluster/namespace/service[:port]" target into
+// its parts. Anything containing "://" (a plain URL) or that doesn't split
+// into exactly three non-empty parts is left for the caller to probe as-is.
+func splitServiceTuple(target string) (cluster, namespace, serviceAndPort string, ok bool) {
+	if strings.Contains(target, "://") {
+		return "", "", "", false
+	}
+
+	parts := strings.Split(target, "/")
+	if len(parts) != 3 {
+		return "", "", "", false
+	}
+	for _, part := range parts {
+		if part == "" {
+			return "", "", "", false
+		}
+	}
+	return parts[0], parts[1], parts[2], true
+}
@@ -0,0 +1,37 @@
+package main
+
+import "testing"
+
+func TestSplitServiceTuple(t *testing.T) {
+	cases := []struct {
+		name          string
+		target        string
+		wantCluster   string
+		wantNamespace string
+		wantService   string
+		wantOK        bool
+	}{
+		{"valid tuple", "prod/default/api", "prod", "default", "api", true},
+		{"valid tuple with port", "prod/default/api:8080", "prod", "default", "api:8080", true},
+		{"plain url", "http://example.com", "", "", "", false},
+		{"plain hostname", "example.com", "", "", "", false},
+		{"too few parts", "default/api", "", "", "", false},
+		{"too many parts", "prod/default/api/extra", "", "", "", false},
+		{"empty part", "prod//api", "", "", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster, namespace, serviceAndPort, ok := splitServiceTuple(tc.target)
+			if ok != tc.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tc.wantOK)
+			}
+			if !tc.wantOK {
+				return
+			}
+			if cluster != tc.wantCluster || namespace != tc.wantNamespace || serviceAndPort != tc.wantService {
+				t.Errorf("got (%q, %q, %q), want (%q, %q, %q)", cluster, namespace, serviceAndPort, tc.wantCluster, tc.wantNamespace, tc.wantService)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	defaultTimeout  = 5 * time.Second
+
+	defaultDegradedThreshold = 1
+	defaultFailingThreshold  = 3
+)
+
+// effectiveSpec validates spec and fills in the Interval/Timeout/threshold
+// defaults a Monitor didn't set.
+func effectiveSpec(spec k8monv1.MonitorSpec) (k8monv1.MonitorSpec, error) {
+	switch spec.Type {
+	case k8monv1.MonitorProtocolHTTP, k8monv1.MonitorProtocolTCP, k8monv1.MonitorProtocolICMP:
+	default:
+		return spec, fmt.Errorf("spec.type %q is not one of http, tcp, icmp", spec.Type)
+	}
+	if spec.Target == "" {
+		return spec, fmt.Errorf("spec.target is required")
+	}
+
+	if spec.Interval.Duration == 0 {
+		spec.Interval = metav1.Duration{Duration: defaultInterval}
+	}
+	if spec.Timeout.Duration == 0 {
+		spec.Timeout = metav1.Duration{Duration: defaultTimeout}
+	}
+	if spec.DegradedThreshold == 0 {
+		spec.DegradedThreshold = defaultDegradedThreshold
+	}
+	if spec.FailingThreshold == 0 {
+		spec.FailingThreshold = defaultFailingThreshold
+	}
+	if spec.FailingThreshold < spec.DegradedThreshold {
+		return spec, fmt.Errorf("spec.failingThreshold (%d) must be >= spec.degradedThreshold (%d)", spec.FailingThreshold, spec.DegradedThreshold)
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+func TestEffectiveSpecRejectsUnknownType(t *testing.T) {
+	_, err := effectiveSpec(k8monv1.MonitorSpec{Type: "ftp", Target: "example.com"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown spec.type, got nil")
+	}
+}
+
+func TestEffectiveSpecRequiresTarget(t *testing.T) {
+	_, err := effectiveSpec(k8monv1.MonitorSpec{Type: k8monv1.MonitorProtocolHTTP})
+	if err == nil {
+		t.Fatal("expected an error for a missing spec.target, got nil")
+	}
+}
+
+func TestEffectiveSpecFillsIntervalAndTimeoutDefaults(t *testing.T) {
+	spec, err := effectiveSpec(k8monv1.MonitorSpec{Type: k8monv1.MonitorProtocolTCP, Target: "example.com:80"})
+	if err != nil {
+		t.Fatalf("effectiveSpec returned an unexpected error: %v", err)
+	}
+	if spec.Interval.Duration != defaultInterval {
+		t.Errorf("Interval = %v, want default %v", spec.Interval.Duration, defaultInterval)
+	}
+	if spec.Timeout.Duration != defaultTimeout {
+		t.Errorf("Timeout = %v, want default %v", spec.Timeout.Duration, defaultTimeout)
+	}
+}
+
+func TestEffectiveSpecFillsThresholdDefaults(t *testing.T) {
+	spec, err := effectiveSpec(k8monv1.MonitorSpec{Type: k8monv1.MonitorProtocolHTTP, Target: "http://example.com"})
+	if err != nil {
+		t.Fatalf("effectiveSpec returned an unexpected error: %v", err)
+	}
+	if spec.DegradedThreshold != defaultDegradedThreshold {
+		t.Errorf("DegradedThreshold = %d, want default %d", spec.DegradedThreshold, defaultDegradedThreshold)
+	}
+	if spec.FailingThreshold != defaultFailingThreshold {
+		t.Errorf("FailingThreshold = %d, want default %d", spec.FailingThreshold, defaultFailingThreshold)
+	}
+}
+
+func TestEffectiveSpecRejectsFailingThresholdBelowDegraded(t *testing.T) {
+	_, err := effectiveSpec(k8monv1.MonitorSpec{
+		Type:              k8monv1.MonitorProtocolHTTP,
+		Target:            "http://example.com",
+		DegradedThreshold: 5,
+		FailingThreshold:  2,
+	})
+	if err == nil {
+		t.Fatal("expected an error when spec.failingThreshold < spec.degradedThreshold, got nil")
+	}
+}
+
+func TestEffectiveSpecPreservesExplicitIntervalAndTimeout(t *testing.T) {
+	spec, err := effectiveSpec(k8monv1.MonitorSpec{
+		Type:     k8monv1.MonitorProtocolICMP,
+		Target:   "example.com",
+		Interval: metav1.Duration{Duration: time.Minute},
+		Timeout:  metav1.Duration{Duration: 2 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("effectiveSpec returned an unexpected error: %v", err)
+	}
+	if spec.Interval.Duration != time.Minute {
+		t.Errorf("Interval = %v, want %v", spec.Interval.Duration, time.Minute)
+	}
+	if spec.Timeout.Duration != 2*time.Second {
+		t.Errorf("Timeout = %v, want %v", spec.Timeout.Duration, 2*time.Second)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+	versioned "github.com/tpretz/k8mon/pkg/generated/clientset/versioned"
+)
+
+const conditionTypeReady = "Ready"
+
+// Monitor health phases, in escalating order.
+const (
+	phaseHealthy  = "Healthy"
+	phaseDegraded = "Degraded"
+	phaseFailing  = "Failing"
+)
+
+// computePhase applies spec's DegradedThreshold/FailingThreshold to
+// consecutiveFailures to decide a Monitor's current health phase.
+func computePhase(spec k8monv1.MonitorSpec, consecutiveFailures int) string {
+	switch {
+	case consecutiveFailures >= int(spec.FailingThreshold):
+		return phaseFailing
+	case consecutiveFailures >= int(spec.DegradedThreshold):
+		return phaseDegraded
+	default:
+		return phaseHealthy
+	}
+}
+
+// probeResult is what a single probe run produces and is persisted onto the
+// Monitor's status subresource.
+type probeResult struct {
+	success             bool
+	err                 error
+	latency             time.Duration
+	consecutiveFailures int
+}
+
+// updateMonitorStatus fetches the current Monitor, applies the probe result
+// to its .status, and writes it back via the status subresource so that
+// editing spec and updating status can never race each other. It returns
+// the Monitor's phase before and after this update, so the caller can tell
+// whether it crossed a Healthy/Degraded/Failing boundary.
+func updateMonitorStatus(ctx context.Context, client versioned.Interface, namespace, name string, spec k8monv1.MonitorSpec, result probeResult) (previousPhase, newPhase string, err error) {
+	monitors := client.K8monV1().Monitors(namespace)
+
+	mon, err := monitors.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("get monitor: %w", err)
+	}
+	updated := mon.DeepCopy()
+
+	previousPhase = mon.Status.Phase
+	newPhase = computePhase(spec, result.consecutiveFailures)
+
+	condStatus := metav1.ConditionTrue
+	reason := "ProbeSucceeded"
+	message := "last probe succeeded"
+	if !result.success {
+		condStatus = metav1.ConditionFalse
+		reason = "ProbeFailed"
+		message = result.err.Error()
+	}
+
+	now := metav1.Now()
+	updated.Status.Phase = newPhase
+	updated.Status.LastProbeTime = now
+	updated.Status.ConsecutiveFailures = int32(result.consecutiveFailures)
+	updated.Status.LatencyMs = result.latency.Milliseconds()
+	updated.Status.Conditions = []metav1.Condition{{
+		Type:               conditionTypeReady,
+		Status:             condStatus,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: now,
+		ObservedGeneration: updated.Generation,
+	}}
+
+	if _, err := monitors.UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return previousPhase, newPhase, fmt.Errorf("update status: %w", err)
+	}
+	return previousPhase, newPhase, nil
+}
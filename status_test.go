@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	k8monv1 "github.com/tpretz/k8mon/pkg/apis/k8mon/v1"
+)
+
+func TestComputePhase(t *testing.T) {
+	spec := k8monv1.MonitorSpec{DegradedThreshold: 2, FailingThreshold: 4}
+
+	cases := []struct {
+		consecutiveFailures int
+		want                string
+	}{
+		{0, phaseHealthy},
+		{1, phaseHealthy},
+		{2, phaseDegraded},
+		{3, phaseDegraded},
+		{4, phaseFailing},
+		{10, phaseFailing},
+	}
+
+	for _, tc := range cases {
+		if got := computePhase(spec, tc.consecutiveFailures); got != tc.want {
+			t.Errorf("computePhase(%d) = %q, want %q", tc.consecutiveFailures, got, tc.want)
+		}
+	}
+}